@@ -0,0 +1,180 @@
+// generated from aggregate.csgo
+
+package cmp
+
+import (
+	"github.com/argennon-project/csgo/transpiled/gnark/api"
+	"github.com/consensys/gnark/backend/hint"
+	"github.com/consensys/gnark/frontend"
+)
+
+import (
+	"github.com/argennon-project/csgo/transpiled/selector"
+	"math/big"
+	"sort"
+)
+
+// MinN returns the minimum of xs. It panics if xs is empty.
+func (bc BoundedComparator) MinN(xs ...frontend.Variable) frontend.Variable {
+	if len(xs) == 0 {
+		panic("MinN needs at least one input")
+	}
+	min := xs[0]
+	for _, x := range xs[1:] {
+		min = bc.Min(min, x)
+	}
+	return min
+}
+
+// MaxN returns the maximum of xs. It panics if xs is empty.
+func (bc BoundedComparator) MaxN(xs ...frontend.Variable) frontend.Variable {
+	if len(xs) == 0 {
+		panic("MaxN needs at least one input")
+	}
+	max := xs[0]
+	for _, x := range xs[1:] {
+		max = bc.max(max, x)
+	}
+	return max
+}
+
+// max returns the maximum of a and b. It is the mirror image of
+// BoundedComparator.Min.
+func (bc BoundedComparator) max(a, b frontend.Variable) frontend.Variable {
+	var res []frontend.Variable
+	res, _ = api.Compiler().NewHint(maxOutputHint, 1, a, b)
+	var max = res[0]
+
+	var aDiff, bDiff = api.Sub(max, a), api.Sub(max, b)
+
+	// (max - a) * (max - b) == 0
+	api.AssertIsEqual(api.Mul(aDiff, bDiff), 0)
+
+	// (max - a) + (max - b) >= 0
+	bc.assertIsNonNegative(api.Add(aDiff, bDiff))
+
+	return max
+}
+
+// ArgMin returns the index of a minimum element of xs, together with a
+// selector-style indicators slice: indicators[i] == 1 for the winning index,
+// and indicators[i] == 0 everywhere else, exactly like the indicators
+// returned by selector.Mux. As with selector.Mux, when len(xs) == 2,
+// indicators will be nil. It panics if xs is empty.
+//
+// If xs has more than one minimal element, index can be the index of any one
+// of them.
+func (bc BoundedComparator) ArgMin(xs ...frontend.Variable) (index frontend.Variable, indicators []frontend.Variable) {
+	if len(xs) == 0 {
+		panic("ArgMin needs at least one input")
+	}
+
+	res, _ := api.Compiler().NewHint(argMinIndexHint, 1, xs...)
+	index = res[0]
+
+	var min frontend.Variable
+	min, indicators = selector.Mux(index, xs...)
+
+	for _, x := range xs {
+		bc.AssertIsLessEq(min, x)
+	}
+	return index, indicators
+}
+
+// SortAscending returns xs sorted in ascending order, together with the
+// permutation that produced it: sorted[i] == xs[j] whenever perm[i][j] == 1,
+// and perm[i][j] == 0 otherwise.
+//
+// It is implemented as a permutation network: a hint produces perm, perm is
+// constrained to be a valid permutation matrix (every entry is
+// boolean, and each row and each column sums to exactly 1), sorted is
+// constrained to equal perm * xs, and finally each adjacent pair of sorted is
+// constrained with AssertIsLessEq.
+func (bc BoundedComparator) SortAscending(xs []frontend.Variable) (sorted []frontend.Variable, perm [][]frontend.Variable) {
+	n := len(xs)
+	flatPerm, _ := api.Compiler().NewHint(sortPermutationHint, n*n, xs...)
+
+	perm = make([][]frontend.Variable, n)
+	sorted = make([]frontend.Variable, n)
+	colSums := make([]frontend.Variable, n)
+	for j := range colSums {
+		colSums[j] = 0
+	}
+
+	for i := 0; i < n; i++ {
+		perm[i] = flatPerm[i*n : (i+1)*n]
+
+		var rowSum, out frontend.Variable = 0, 0
+		for j := 0; j < n; j++ {
+			entry := perm[i][j]
+			// entry must be boolean.
+			api.AssertIsEqual(api.Mul(entry, api.Sub(1, entry)), 0)
+			rowSum = api.Add(rowSum, entry)
+			out = api.Add(out, api.Mul(entry, xs[j]))
+			colSums[j] = api.Add(colSums[j], entry)
+		}
+		api.AssertIsEqual(rowSum, 1)
+		sorted[i] = out
+	}
+	for j := 0; j < n; j++ {
+		api.AssertIsEqual(colSums[j], 1)
+	}
+
+	for i := 0; i+1 < n; i++ {
+		bc.AssertIsLessEq(sorted[i], sorted[i+1])
+	}
+	return sorted, perm
+}
+
+// maxOutputHint produces the output of [BoundedComparator.max] as a hint.
+func maxOutputHint(fieldOrder *big.Int, inputs, results []*big.Int) error {
+	a := inputs[0]
+	b := inputs[1]
+
+	if cmpInField(a, b, fieldOrder) == 1 {
+		// a > b
+		results[0].Set(a)
+	} else {
+		results[0].Set(b)
+	}
+	return nil
+}
+
+// argMinIndexHint produces the output of [BoundedComparator.ArgMin] as a hint.
+func argMinIndexHint(fieldOrder *big.Int, inputs, results []*big.Int) error {
+	best := 0
+	for i := 1; i < len(inputs); i++ {
+		if cmpInField(inputs[i], inputs[best], fieldOrder) == -1 {
+			best = i
+		}
+	}
+	results[0].SetInt64(int64(best))
+	return nil
+}
+
+// sortPermutationHint produces the permutation matrix used by
+// [BoundedComparator.SortAscending], flattened in row-major order.
+func sortPermutationHint(fieldOrder *big.Int, inputs, results []*big.Int) error {
+	n := len(inputs)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return cmpInField(inputs[order[i]], inputs[order[j]], fieldOrder) == -1
+	})
+
+	for i := range results {
+		results[i].SetUint64(0)
+	}
+	for row, col := range order {
+		results[row*n+col].SetUint64(1)
+	}
+	return nil
+}
+
+func init() {
+	hint.Register(maxOutputHint)
+	hint.Register(argMinIndexHint)
+	hint.Register(sortPermutationHint)
+}
@@ -25,8 +25,8 @@ import (
 // NewBoundedComparator, for more information.
 type BoundedComparator struct {
 	// absDiffUppBitLen is the assumed maximum length for the binary representation
-	// of |a - b|. Every method preforms exactly one binary decomposition of this
-	// length.
+	// of |a - b|. Every pairwise method (AssertIsLessEq, AssertIsLess, IsLess,
+	// IsLessEq and Min) performs exactly one range check of this length.
 	absDiffUppBitLen int
 
 	// we will use value receiver for methods of this struct,
@@ -125,7 +125,11 @@ func NewBoundedComparator(absDiffUpp *big.Int, allowNonDeterminism bool) *Bounde
 }
 
 func (bc BoundedComparator) assertIsNonNegative(a frontend.Variable) {
-	convert.AssertBitLen(bc.absDiffUppBitLen, a)
+	// The constraints in NewBoundedComparator already guarantee that
+	// absDiffUppBitLen is far enough from the field's bit length for the
+	// binary decomposition to stay unique, so the extra modulus check that
+	// AssertBitLen would otherwise add is redundant here.
+	convert.AssertBitLen(bc.absDiffUppBitLen, a, convert.OmitModulusCheck())
 }
 
 // AssertIsLessEq defines a set of constraints that can be satisfied
@@ -0,0 +1,88 @@
+// generated from unbounded.csgo
+
+package cmp
+
+import (
+	"github.com/argennon-project/csgo/transpiled/gnark/api"
+	"github.com/consensys/gnark/frontend"
+)
+
+import (
+	"github.com/argennon-project/csgo/transpiled/convert"
+	"github.com/argennon-project/csgo/transpiled/runtime"
+	"github.com/argennon-project/csgo/transpiled/selector"
+)
+
+// IsLess returns 1 if a < b, and returns 0 if a >= b. Unlike the methods of
+// BoundedComparator, IsLess makes no assumption about a bound on |a - b|: a
+// and b can be any values in [0, P-1], where P is the order of the underlying
+// field.
+//
+// Since IsLess performs a full-width binary decomposition, it is considerably
+// more expensive than BoundedComparator.IsLess and should only be used when
+// no tight bound on |a - b| is available.
+func IsLess(a, b frontend.Variable) frontend.Variable {
+	var res []frontend.Variable
+	res, _ = api.Compiler().NewHint(isLessOutputHint, 1, a, b)
+	// a < b  <==> b - a - 1 >= 0
+	// a >= b <==> a - b >= 0
+	var temp, _ = selector.Mux(res[0], api.Sub(a, b), api.Sub(api.Sub(b, a), 1))
+	assertIsNonNegative(temp)
+	return res[0]
+}
+
+// IsLessEq returns 1 if a <= b, and returns 0 if a > b.
+func IsLessEq(a, b frontend.Variable) frontend.Variable {
+	// a <= b <==> a < b + 1
+	return IsLess(a, api.Add(b, 1))
+}
+
+// AssertIsLess defines a set of constraints that can be satisfied only if a < b.
+func AssertIsLess(a, b frontend.Variable) {
+	// a < b <==> a <= b - 1
+	AssertIsLessEq(a, api.Sub(b, 1))
+}
+
+// AssertIsLessEq defines a set of constraints that can be satisfied
+// only if a <= b.
+func AssertIsLessEq(a, b frontend.Variable) {
+	// a <= b <==> b - a >= 0
+	assertIsNonNegative(api.Sub(b, a))
+}
+
+// assertIsNonNegative asserts that a, seen as an element of [0, P-1], is the
+// non-negative side of a pair produced by one of the comparison functions
+// above. Since no bound on a is known, it must be decomposed into the full
+// bit length of the field, which convert.AssertBitLen always guards, by
+// default, with the extra assertion that rules out the a + P decomposition.
+func assertIsNonNegative(a frontend.Variable) {
+	convert.AssertBitLen(runtime.FieldOrder().BitLen(), a)
+}
+
+// IsLessBinary returns 1 if the integer represented by aBits is less than the
+// one represented by bBits, and returns 0 otherwise. aBits and bBits must
+// have the same length, be ordered from the least to the most significant
+// bit, and their elements are assumed to already be boolean.
+//
+// IsLessBinary is useful to callers that already have their operands
+// decomposed into bits, since it lets them skip the cost of a fresh binary
+// decomposition.
+func IsLessBinary(aBits, bBits []frontend.Variable) frontend.Variable {
+	if len(aBits) != len(bBits) {
+		panic("aBits and bBits must have the same length")
+	}
+
+	var isLess frontend.Variable = 0
+	var eqSoFar frontend.Variable = 1
+	for i := len(aBits) - 1; i >= 0; i-- {
+		// aBits[i] < bBits[i] <==> aBits[i] == 0 && bBits[i] == 1
+		aLtB := api.Mul(api.Sub(1, aBits[i]), bBits[i])
+		isLess = api.Add(isLess, api.Mul(eqSoFar, aLtB))
+
+		// bitsEq is 1 when aBits[i] == bBits[i], and 0 otherwise.
+		bitsXor := api.Sub(api.Add(aBits[i], bBits[i]), api.Mul(2, api.Mul(aBits[i], bBits[i])))
+		bitsEq := api.Sub(1, bitsXor)
+		eqSoFar = api.Mul(eqSoFar, bitsEq)
+	}
+	return isLess
+}
@@ -4,13 +4,108 @@ package convert
 
 import (
 	"github.com/argennon-project/csgo/internal/api"
+	"github.com/argennon-project/csgo/transpiled/rangecheck"
+	"github.com/argennon-project/csgo/transpiled/runtime"
 	"github.com/consensys/gnark/frontend"
+	"math/big"
 )
 
 import "github.com/consensys/gnark/std/math/bits"
 
+// checker is the shared range-check gadget used by AssertBitLen, for the
+// circuit currently being built. checkerAPI records which api.Api it was
+// built from, so that compiling more than one circuit in the same process
+// (e.g. a test suite, or a long-running prover service) rebuilds checker
+// instead of reusing one still bound to a stale, already-finalized builder.
+var (
+	checker    rangecheck.Checker
+	checkerAPI frontend.API
+)
+
+func getChecker() rangecheck.Checker {
+	if checker == nil || checkerAPI != api.Api {
+		checker = rangecheck.New(api.Api)
+		checkerAPI = api.Api
+	}
+	return checker
+}
+
+// options holds the configuration accepted by AssertBitLen.
+type options struct {
+	omitModulusCheck bool
+}
+
+// Option configures the behaviour of AssertBitLen.
+type Option func(*options)
+
+// OmitModulusCheck skips the extra assertion that AssertBitLen otherwise adds,
+// by default, whenever bitLen is at least the field's bit length.
+//
+// Only pass this when the binary decomposition is already known to be
+// unique, e.g. because bitLen is backed by a bound that was already checked
+// against the field order elsewhere.
+func OmitModulusCheck() Option {
+	return func(o *options) {
+		o.omitModulusCheck = true
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, apply := range opts {
+		apply(&o)
+	}
+	return o
+}
+
 // AssertBitLen ensures that the binary representation of x has less than bitLen bits. It assumes that x is an unsigned
 // number between 0 and P - 1, where P is the order of the underlying field.
-func AssertBitLen(bitLen int, x frontend.Variable) {
-	bits.ToBinary(api.Api, x, bits.WithNbDigits(bitLen))
+//
+// When bitLen is at least P's bit length, a binary decomposition of that
+// length is not unique: x could also be represented by the decomposition of
+// x + P, since that value still fits in bitLen bits. A malicious prover
+// could exploit this to supply a decomposition of x + P instead of x. To
+// rule this out, AssertBitLen additionally asserts, in that case, that the
+// produced bits, read as an integer, are strictly less than P. Pass
+// OmitModulusCheck to skip this extra assertion when the caller has already
+// established, by other means, that the decomposition is unique.
+//
+// Note that bitLen == P.BitLen()-1 never needs this extra assertion: a
+// decomposition of that length can represent at most 2^(P.BitLen()-1) - 1,
+// which is always smaller than P, so the decomposition is already unique.
+func AssertBitLen(bitLen int, x frontend.Variable, opts ...Option) {
+	cfg := newOptions(opts)
+	if !cfg.omitModulusCheck && bitLen >= runtime.FieldOrder().BitLen() {
+		xBits := bits.ToBinary(api.Api, x, bits.WithNbDigits(bitLen))
+		AssertLessThanConst(xBits, runtime.FieldOrder())
+		return
+	}
+	getChecker().Check(x, bitLen)
+}
+
+// AssertLessThanConst asserts that the integer represented by xBits, ordered
+// from the least to the most significant bit, is strictly less than bound.
+// bound must fit within len(xBits) bits, or this function panics.
+//
+// It scans xBits from the most significant bit down, maintaining an
+// indicator of whether the bits seen so far are already strictly less than
+// the corresponding bits of bound. xBits is only allowed to equal bound's
+// bits while that indicator is still 0, which forces a strict less-than
+// decision before the least significant bit is reached.
+func AssertLessThanConst(xBits []frontend.Variable, bound *big.Int) {
+	if bound.BitLen() > len(xBits) {
+		panic("bound does not fit within len(xBits) bits")
+	}
+
+	var lessSoFar frontend.Variable = 0
+	for i := len(xBits) - 1; i >= 0; i-- {
+		if bound.Bit(i) == 1 {
+			lessSoFar = api.Api.Add(lessSoFar, api.Api.Mul(api.Api.Sub(1, lessSoFar), api.Api.Sub(1, xBits[i])))
+		} else {
+			// bound's bit is 0 here, so xBits[i] can only be 1 while we are already
+			// guaranteed to be strictly less because of a more significant bit.
+			api.Api.AssertIsEqual(api.Api.Mul(api.Api.Sub(1, lessSoFar), xBits[i]), 0)
+		}
+	}
+	api.Api.AssertIsEqual(lessSoFar, 1)
 }
@@ -0,0 +1,28 @@
+// generated from rangecheck.csgo
+
+package rangecheck
+
+import (
+	"github.com/consensys/gnark/frontend"
+	gnarkrangecheck "github.com/consensys/gnark/std/rangecheck"
+)
+
+// Checker asserts that a circuit variable fits within a given number of bits,
+// using whichever strategy is cheapest for the underlying builder.
+//
+// When the builder exposes a native lookup-gate range check (PLONKish
+// backends implementing frontend.Rangechecker), a Checker delegates to it
+// directly. Otherwise, every call to Check is batched and discharged
+// together, at circuit compile end, against a single shared lookup table, so
+// that an n-bit check costs roughly ceil(n / tableWidth) lookups instead of n
+// boolean constraints. When neither option is available, as is the case for
+// a plain R1CS builder, it falls back to a binary decomposition.
+type Checker = gnarkrangecheck.Checker
+
+// New creates a Checker for api. The same Checker should be reused for every
+// range check performed against a given circuit, so batched checks are
+// discharged together and the underlying lookup argument is materialized
+// only once.
+func New(api frontend.API) Checker {
+	return gnarkrangecheck.New(api)
+}